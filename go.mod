@@ -8,7 +8,23 @@ go 1.21.11
 // logrus 是一个结构化的日志库，用于记录程序的日志，方便调试和生产环境的日志管理。
 require github.com/sirupsen/logrus v1.9.3
 
-// 引入外部依赖：golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8（间接依赖）
+// 引入外部依赖：golang.org/x/sys v0.20.0（间接依赖）
 // golang.org/x/sys 是Go语言的系统级包，提供了访问底层操作系统功能的接口。
 // 该依赖是间接依赖（即在直接依赖的库中被间接引用）。
-require golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8 // indirect
+require golang.org/x/sys v0.20.0 // indirect
+
+// 引入外部依赖：golang.org/x/net v0.25.0
+// golang.org/x/net 提供 http2 与 h2c 支持，用于到上游的 HTTP/2 以及监听侧的 HTTP/2 明文。
+require golang.org/x/net v0.25.0
+
+// 引入外部依赖：golang.org/x/text v0.15.0（间接依赖）
+// golang.org/x/text 由 golang.org/x/net 间接引用。
+require golang.org/x/text v0.15.0 // indirect
+
+// 引入外部依赖：golang.org/x/time v0.5.0
+// golang.org/x/time 提供令牌桶限速器，用于限制镜像拉取的带宽。
+require golang.org/x/time v0.5.0
+
+// 引入外部依赖：golang.org/x/sync v0.7.0
+// golang.org/x/sync 提供 errgroup 等并发工具，用于同时运行多个监听服务。
+require golang.org/x/sync v0.7.0
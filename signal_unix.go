@@ -0,0 +1,18 @@
+//go:build !windows
+
+package main
+
+import (
+  "os"
+  "os/signal"
+  "syscall"
+)
+
+// watchLogLevelSignal 收到 SIGUSR1 时切换日志级别，无需重启即可临时开启 debug
+func watchLogLevelSignal() {
+  sigChan := make(chan os.Signal, 1)
+  signal.Notify(sigChan, syscall.SIGUSR1)
+  for range sigChan {
+    toggleLogLevel()
+  }
+}
@@ -0,0 +1,6 @@
+//go:build windows
+
+package main
+
+// watchLogLevelSignal Windows 不支持 SIGUSR1，请使用 --admin-listen 的 /loglevel 接口
+func watchLogLevelSignal() {}